@@ -0,0 +1,50 @@
+package easy_lru_cache
+
+import "testing"
+
+// TestARCCacheBoundedSize exercises the scenario where every entry in t1
+// gets promoted to t2 via Get before more distinct keys are inserted. A
+// missing Case-B eviction path would let t1.Len()+t2.Len() grow past the
+// configured size.
+func TestARCCacheBoundedSize(t *testing.T) {
+	const size = 4
+	c, err := NewARC(size)
+	if err != nil {
+		t.Fatalf("NewARC: %v", err)
+	}
+
+	for i := 0; i < size; i++ {
+		if err := c.Add(i, i); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+	}
+	for i := 0; i < size; i++ {
+		if _, err := c.Get(i); err != nil {
+			t.Fatalf("Get(%d): %v", i, err)
+		}
+	}
+
+	for i := size; i < size*2; i++ {
+		if err := c.Add(i, i); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+		if got := c.Len(); got > size {
+			t.Fatalf("Len() = %d after Add(%d), want <= %d", got, i, size)
+		}
+	}
+}
+
+func TestARCCacheRemove(t *testing.T) {
+	c, err := NewARC(2)
+	if err != nil {
+		t.Fatalf("NewARC: %v", err)
+	}
+	if err := c.Add("a", 1); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	c.Remove("a")
+	if c.Contains("a") {
+		t.Fatalf("Contains(%q) = true after Remove", "a")
+	}
+}
@@ -26,7 +26,12 @@ type twoQueueCache struct {
 	frequent    LRUCache
 	recentEvict LRUCache
 	lock        sync.RWMutex
-	expiration  *time.Time
+
+	defaultTTL  time.Duration
+	stopJanitor chan struct{}
+
+	onEvict EvictCallback
+	metrics Metrics
 }
 
 // New2Q creates a new twoQueueCache using the default
@@ -83,33 +88,96 @@ func New2QParams(size int, recentRatio, ghostRatio float64) (cache *twoQueueCach
 		frequent:    frequent,
 		recentEvict: recentEvict,
 	}
+
+	// Forward lazy-expiry evictions from the inner LRUs up to the 2Q
+	// cache's own callback/metrics. Other reasons are left alone since
+	// the 2Q cache already reports those itself.
+	recent.onEvict = cache.forwardExpiry
+	frequent.onEvict = cache.forwardExpiry
+	recentEvict.onEvict = cache.forwardExpiry
 	return
 }
 
 // Get looks up a key's value from the cache.
 func (t *twoQueueCache) Get(key interface{}) (value interface{}, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
 	if value, err = t.frequent.Get(key); err == nil {
+		if t.metrics != nil {
+			t.metrics.Hit()
+		}
 		return
 	}
 
-	// If the value is contained in recent, then we
-	// promote it to frequent
-	if value, ok := t.recent.Peek(key); ok {
+	// If the value is contained in recent, then we promote it to
+	// frequent, carrying its expiration along so a promoted entry
+	// doesn't become immortal.
+	if v, expiration, ok := t.recent.PeekWithExpiration(key); ok {
+		value = v
 		t.recent.Remove(key)
-		err = t.frequent.Put(key, value)
+		if expiration != nil {
+			err = t.frequent.PutWithExpiration(key, value, *expiration)
+		} else {
+			err = t.frequent.Put(key, value)
+		}
+		if t.metrics != nil {
+			t.metrics.Hit()
+			t.metrics.PromotionR2F()
+		}
+		if t.onEvict != nil {
+			t.onEvict(key, value, ReasonPromoted)
+		}
+		return
+	}
+
+	if t.metrics != nil {
+		t.metrics.Miss()
 	}
 	return
 }
 
 // Add adds a value to the cache.
 func (t *twoQueueCache) Add(key, value interface{}) (err error) {
+	return t.addWithExpiration(key, value, t.defaultExpiration())
+}
+
+// PutWithTTL adds a value to the cache that expires after the given
+// duration.
+func (t *twoQueueCache) PutWithTTL(key, value interface{}, ttl time.Duration) (err error) {
+	exp := time.Now().Add(ttl)
+	return t.addWithExpiration(key, value, &exp)
+}
+
+// PutWithExpiration adds a value to the cache that expires at the given
+// time.
+func (t *twoQueueCache) PutWithExpiration(key, value interface{}, exp time.Time) (err error) {
+	return t.addWithExpiration(key, value, &exp)
+}
+
+func (t *twoQueueCache) defaultExpiration() *time.Time {
+	if t.defaultTTL <= 0 {
+		return nil
+	}
+	exp := time.Now().Add(t.defaultTTL)
+	return &exp
+}
+
+func (t *twoQueueCache) addWithExpiration(key, value interface{}, expiration *time.Time) (err error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
+	put := func(c LRUCache) error {
+		if expiration != nil {
+			return c.PutWithExpiration(key, value, *expiration)
+		}
+		return c.Put(key, value)
+	}
+
 	// Check if the value is frequently used already,
 	// and just update the value
 	if t.frequent.Contains(key) {
-		err = t.frequent.Put(key, value)
+		err = put(t.frequent)
 		return
 	}
 
@@ -117,7 +185,13 @@ func (t *twoQueueCache) Add(key, value interface{}) (err error) {
 	// the value into the frequent list
 	if t.recent.Contains(key) {
 		t.recent.Remove(key)
-		err = t.frequent.Put(key, value)
+		err = put(t.frequent)
+		if t.metrics != nil {
+			t.metrics.PromotionR2F()
+		}
+		if t.onEvict != nil {
+			t.onEvict(key, value, ReasonPromoted)
+		}
 		return
 	}
 
@@ -129,7 +203,10 @@ func (t *twoQueueCache) Add(key, value interface{}) (err error) {
 			return
 		}
 		t.recentEvict.Remove(key)
-		err = t.frequent.Put(key, value)
+		err = put(t.frequent)
+		if t.metrics != nil {
+			t.metrics.GhostHit()
+		}
 		return
 	}
 
@@ -138,7 +215,7 @@ func (t *twoQueueCache) Add(key, value interface{}) (err error) {
 	if err != nil {
 		return
 	}
-	err = t.recent.Put(key, value)
+	err = put(t.recent)
 	return
 }
 
@@ -154,15 +231,55 @@ func (t *twoQueueCache) ensureSpace(recentEvict bool) (err error) {
 	// If the recent buffer is larger than
 	// the target, evict from there
 	if recentLen > 0 && (recentLen > t.recentSize || (recentLen == t.recentSize && !recentEvict)) {
-		err = t.recent.RemoveOldest()
-		return
+		return t.evictOldest(t.recent)
 	}
 
 	// Remove from the frequent list otherwise
-	err = t.frequent.RemoveOldest()
+	return t.evictOldest(t.frequent)
+}
+
+// evictOldest removes the oldest entry of c, reporting it through the
+// configured evict callback and metrics.
+func (t *twoQueueCache) evictOldest(c LRUCache) (err error) {
+	keys := c.Keys()
+	if len(keys) == 0 {
+		return c.RemoveOldest()
+	}
+	oldest := keys[0]
+	value, ok := c.Peek(oldest)
+	if !ok {
+		// Peek already dropped the expired oldest entry, which frees its slot.
+		return
+	}
+	if err = c.RemoveOldest(); err != nil {
+		return
+	}
+	t.fireEvict(oldest, value, ReasonCapacity)
 	return
 }
 
+// fireEvict reports an entry leaving the cache through the configured
+// evict callback and metrics.
+func (t *twoQueueCache) fireEvict(key, value interface{}, reason EvictReason) {
+	if t.onEvict != nil {
+		t.onEvict(key, value, reason)
+	}
+	if t.metrics != nil {
+		t.metrics.Eviction(reason)
+	}
+}
+
+// forwardExpiry is wired in as the inner recent/frequent/recentEvict
+// LRUs' onEvict callback so a lazily-expired entry is still reported
+// through the 2Q cache's own callback/metrics. Other reasons are
+// ignored since the 2Q cache already fires those itself.
+func (t *twoQueueCache) forwardExpiry(key, value interface{}, reason EvictReason) {
+	if reason != ReasonExpired {
+		return
+	}
+	t.fireEvict(key, value, reason)
+}
+
 // Len returns the number of items in the cache.
 func (t *twoQueueCache) Len() int {
 	t.lock.RLock()
@@ -184,13 +301,19 @@ func (t *twoQueueCache) Keys() []interface{} {
 func (t *twoQueueCache) Remove(key interface{}) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	if t.frequent.Remove(key) {
+	if value, ok := t.frequent.Peek(key); ok {
+		t.frequent.Remove(key)
+		t.fireEvict(key, value, ReasonExplicit)
 		return
 	}
-	if t.recent.Remove(key) {
+	if value, ok := t.recent.Peek(key); ok {
+		t.recent.Remove(key)
+		t.fireEvict(key, value, ReasonExplicit)
 		return
 	}
-	if t.recentEvict.Remove(key) {
+	if value, ok := t.recentEvict.Peek(key); ok {
+		t.recentEvict.Remove(key)
+		t.fireEvict(key, value, ReasonExplicit)
 		return
 	}
 }
@@ -222,3 +345,101 @@ func (t *twoQueueCache) Peek(key interface{}) (value interface{}, ok bool) {
 	}
 	return t.recent.Peek(key)
 }
+
+// purgeExpiredAll walks each underlying lru tail-first and purges
+// expired entries.
+func (t *twoQueueCache) purgeExpiredAll() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, c := range []LRUCache{t.recent, t.frequent, t.recentEvict} {
+		if l, ok := c.(*lru); ok {
+			l.purgeExpired()
+		}
+	}
+}
+
+// startJanitor starts a goroutine that periodically purges expired
+// entries. It is only started when a non-zero default TTL is configured.
+func (t *twoQueueCache) startJanitor(interval time.Duration) {
+	stop := make(chan struct{})
+	t.stopJanitor = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.purgeExpiredAll()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// Close stops the background janitor goroutine, if one was started.
+func (t *twoQueueCache) Close() error {
+	if t.stopJanitor != nil {
+		close(t.stopJanitor)
+		t.stopJanitor = nil
+	}
+	return nil
+}
+
+// Stats returns a snapshot of the cache's event counters. It only
+// reports non-zero data when the cache was built via New2QWithOptions
+// without a custom Metrics implementation.
+func (t *twoQueueCache) Stats() Stats {
+	if dm, ok := t.metrics.(*defaultMetrics); ok {
+		return dm.Snapshot()
+	}
+	return Stats{}
+}
+
+// New2QWithOptions creates a new twoQueueCache with the provided
+// eviction callback, metrics, ratio overrides, and/or default TTL wired
+// in. If no Metrics is supplied via WithMetrics, an in-memory
+// implementation is used and can be read back via Stats.
+func New2QWithOptions(size int, opts ...Option) (cache *twoQueueCache, err error) {
+	o := &cacheOptions{
+		recentRatio: Default2QRecentRatio,
+		ghostRatio:  Default2QGhostEntries,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	cache, err = New2QParams(size, o.recentRatio, o.ghostRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.onEvict = o.onEvict
+	if o.metrics != nil {
+		cache.metrics = o.metrics
+	} else {
+		cache.metrics = &defaultMetrics{}
+	}
+
+	cache.defaultTTL = o.defaultTTL
+	if o.defaultTTL > 0 {
+		cache.startJanitor(o.defaultTTL)
+	}
+	return cache, nil
+}
+
+// New2QWithDefaultTTL creates a new twoQueueCache using the default 2Q
+// parameters whose entries expire after ttl unless added with an
+// explicit TTL or expiration, and starts a janitor goroutine that
+// periodically purges expired entries.
+func New2QWithDefaultTTL(size int, ttl time.Duration) (cache *twoQueueCache, err error) {
+	cache, err = New2Q(size, 0.0, 0.0)
+	if err != nil {
+		return nil, err
+	}
+	cache.defaultTTL = ttl
+	if ttl > 0 {
+		cache.startJanitor(ttl)
+	}
+	return cache, nil
+}
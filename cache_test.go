@@ -0,0 +1,108 @@
+package easy_lru_cache
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTwoQueueCacheGetPromotesToFrequent verifies that a second Get for a
+// key still sitting in recent promotes it into frequent and returns the
+// correct value, rather than the zero value from a shadowed return.
+func TestTwoQueueCacheGetPromotesToFrequent(t *testing.T) {
+	c, err := New2Q(8, 0, 0)
+	if err != nil {
+		t.Fatalf("New2Q: %v", err)
+	}
+
+	if err := c.Add("a", "one"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	value, err := c.Get("a")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if value != "one" {
+		t.Fatalf("Get(%q) = %v, want %q", "a", value, "one")
+	}
+	if !c.frequent.Contains("a") {
+		t.Fatalf("%q was not promoted to frequent after Get", "a")
+	}
+
+	value, err = c.Get("a")
+	if err != nil {
+		t.Fatalf("Get after promotion: %v", err)
+	}
+	if value != "one" {
+		t.Fatalf("Get(%q) after promotion = %v, want %q", "a", value, "one")
+	}
+}
+
+// TestTwoQueueCacheGetPromotionPreservesTTL verifies that promoting a
+// key from recent to frequent carries its expiration along, so it still
+// expires instead of becoming immortal.
+func TestTwoQueueCacheGetPromotionPreservesTTL(t *testing.T) {
+	c, err := New2Q(8, 0, 0)
+	if err != nil {
+		t.Fatalf("New2Q: %v", err)
+	}
+
+	if err := c.PutWithTTL("a", "one", 30*time.Millisecond); err != nil {
+		t.Fatalf("PutWithTTL: %v", err)
+	}
+
+	if _, err := c.Get("a"); err != nil {
+		t.Fatalf("Get (promotion): %v", err)
+	}
+	if !c.frequent.Contains("a") {
+		t.Fatalf("%q was not promoted to frequent after Get", "a")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if _, err := c.Get("a"); err == nil {
+		t.Fatalf("Get(%q) after TTL expiry = nil error, want error", "a")
+	}
+}
+
+// TestTwoQueueCacheReportsExpiredEviction verifies that an expired entry
+// purged from an inner LRU by the janitor's purge is still reported
+// through the 2Q cache's own eviction callback, via New2QWithOptions'
+// WithDefaultTTL.
+func TestTwoQueueCacheReportsExpiredEviction(t *testing.T) {
+	var reasons []EvictReason
+	c, err := New2QWithOptions(8,
+		WithDefaultTTL(10*time.Millisecond),
+		WithEvictCallback(func(key, value interface{}, reason EvictReason) {
+			reasons = append(reasons, reason)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("New2QWithOptions: %v", err)
+	}
+	defer c.Close()
+
+	if err := c.Add("a", "one"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	time.Sleep(40 * time.Millisecond)
+	c.purgeExpiredAll()
+
+	for _, r := range reasons {
+		if r == ReasonExpired {
+			return
+		}
+	}
+	t.Fatalf("no ReasonExpired eviction reported, got %v", reasons)
+}
+
+func TestTwoQueueCacheGetMiss(t *testing.T) {
+	c, err := New2Q(8, 0, 0)
+	if err != nil {
+		t.Fatalf("New2Q: %v", err)
+	}
+	if _, err := c.Get("missing"); err == nil {
+		t.Fatalf("Get(missing) = nil error, want error")
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"container/list"
 	"errors"
 	"sync"
-	//"time"
+	"time"
 )
 
 // Key is any value which is comparable.
@@ -17,8 +17,11 @@ type Value interface{}
 type LRUCache interface {
 	Get(key interface{}) (value Value, err error)
 	Put(key, value interface{}) (err error)
+	PutWithTTL(key, value interface{}, ttl time.Duration) (err error)
+	PutWithExpiration(key, value interface{}, exp time.Time) (err error)
 	Contains(key interface{}) (ok bool)
 	Peek(key interface{}) (value interface{}, ok bool)
+	PeekWithExpiration(key interface{}) (value interface{}, expiration *time.Time, ok bool)
 	Remove(key interface{}) (ok bool)
 	Len() int
 	RemoveOldest() (err error)
@@ -30,7 +33,11 @@ type lruItem struct {
 	key   Key
 	value Value
 
-	//expiration *time.Time
+	expiration *time.Time
+}
+
+func (i *lruItem) expired(now time.Time) bool {
+	return i.expiration != nil && now.After(*i.expiration)
 }
 
 // lru implements a non-thread safe fixed size lru cache
@@ -39,22 +46,68 @@ type lru struct {
 	list     *list.List
 	cache    map[interface{}]*list.Element
 	lock     sync.RWMutex
+
+	defaultTTL  time.Duration
+	stopJanitor chan struct{}
+
+	onEvict EvictCallback
+	metrics Metrics
 }
 
 func (l *lru) Get(key interface{}) (value Value, err error) {
-	l.lock.RLock()
+	l.lock.Lock()
 	defer l.lock.Unlock()
 	element, has := l.cache[key]
 	if !has {
+		if l.metrics != nil {
+			l.metrics.Miss()
+		}
+		err = errors.New("not found in cache")
+		return
+	}
+	item := element.Value.(*lruItem)
+	if item.expired(time.Now()) {
+		l.removeElementReason(element, ReasonExpired)
+		if l.metrics != nil {
+			l.metrics.Miss()
+		}
 		err = errors.New("not found in cache")
 		return
 	}
 	l.list.MoveBefore(element, l.list.Front())
-	value = element.Value.(*lruItem).value
+	value = item.value
+	if l.metrics != nil {
+		l.metrics.Hit()
+	}
 	return
 }
 
 func (l *lru) Put(key, value interface{}) (err error) {
+	return l.putWithExpiration(key, value, l.defaultExpiration())
+}
+
+// PutWithTTL adds a value to the cache that expires after the given
+// duration.
+func (l *lru) PutWithTTL(key, value interface{}, ttl time.Duration) (err error) {
+	exp := time.Now().Add(ttl)
+	return l.putWithExpiration(key, value, &exp)
+}
+
+// PutWithExpiration adds a value to the cache that expires at the given
+// time.
+func (l *lru) PutWithExpiration(key, value interface{}, exp time.Time) (err error) {
+	return l.putWithExpiration(key, value, &exp)
+}
+
+func (l *lru) defaultExpiration() *time.Time {
+	if l.defaultTTL <= 0 {
+		return nil
+	}
+	exp := time.Now().Add(l.defaultTTL)
+	return &exp
+}
+
+func (l *lru) putWithExpiration(key, value interface{}, expiration *time.Time) (err error) {
 	var item *lruItem
 	l.lock.Lock()
 	defer l.lock.Unlock()
@@ -62,6 +115,7 @@ func (l *lru) Put(key, value interface{}) (err error) {
 		l.list.MoveToFront(it)
 		item = it.Value.(*lruItem)
 		item.value = value
+		item.expiration = expiration
 		return
 	}
 
@@ -69,8 +123,9 @@ func (l *lru) Put(key, value interface{}) (err error) {
 		l.removeOldest()
 	}
 	item = &lruItem{
-		key:   key,
-		value: value,
+		key:        key,
+		value:      value,
+		expiration: expiration,
 	}
 	l.cache[key] = l.list.PushFront(item)
 	return
@@ -79,35 +134,83 @@ func (l *lru) Put(key, value interface{}) (err error) {
 // Peek returns the key value (or undefined if not found) without updating
 // the "recently used"-ness of the key.
 func (l *lru) Peek(key interface{}) (value interface{}, ok bool) {
-	if element, ok := l.cache[key]; ok {
-		value = element.Value.(*lruItem).value
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	element, has := l.cache[key]
+	if !has {
+		return
+	}
+	item := element.Value.(*lruItem)
+	if item.expired(time.Now()) {
+		l.removeElementReason(element, ReasonExpired)
+		return
+	}
+	value = item.value
+	ok = true
+	return
+}
+
+// PeekWithExpiration returns a key's value and expiration (nil if it
+// never expires) without updating the "recently used"-ness of the key.
+// It lets a caller that moves an entry between LRUs, such as a 2Q
+// promotion, carry the entry's TTL along with it.
+func (l *lru) PeekWithExpiration(key interface{}) (value interface{}, expiration *time.Time, ok bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	element, has := l.cache[key]
+	if !has {
+		return
+	}
+	item := element.Value.(*lruItem)
+	if item.expired(time.Now()) {
+		l.removeElementReason(element, ReasonExpired)
+		return
 	}
+	value = item.value
+	expiration = item.expiration
+	ok = true
 	return
 }
 
 // Contains checks if a key is in the cache, without updating the recent-ness
 // or deleting it for being stale.
 func (l *lru) Contains(key interface{}) (ok bool) {
-	_, ok = l.cache[key]
-	return ok
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	element, has := l.cache[key]
+	if !has {
+		return false
+	}
+	if element.Value.(*lruItem).expired(time.Now()) {
+		l.removeElementReason(element, ReasonExpired)
+		return false
+	}
+	return true
 }
 
 // Remove removes the provided key from the cache, returning if the
 // key was contained.
 func (l *lru) Remove(key interface{}) (ok bool) {
-	if element, ok := l.cache[key]; ok {
-		l.removeElement(element)
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	element, ok := l.cache[key]
+	if ok {
+		l.removeElementReason(element, ReasonExplicit)
 	}
 	return
 }
 
 // Len returns the number of items in the cache.
 func (l *lru) Len() int {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
 	return l.list.Len()
 }
 
 // RemoveOldest removes the oldest item from the cache.
 func (l *lru) RemoveOldest() (err error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
 	ent := l.list.Back()
 	if ent != nil {
 		l.removeElement(ent)
@@ -117,6 +220,8 @@ func (l *lru) RemoveOldest() (err error) {
 
 // Keys returns a slice of the keys in the cache, from oldest to newest.
 func (l *lru) Keys() []interface{} {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
 	keys := make([]interface{}, len(l.cache))
 	i := 0
 	for ent := l.list.Back(); ent != nil; ent = ent.Prev() {
@@ -128,12 +233,66 @@ func (l *lru) Keys() []interface{} {
 
 // Purge is used to completely clear the cache.
 func (l *lru) Purge() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
 	for k, _ := range l.cache {
 		delete(l.cache, k)
 	}
 	l.list.Init()
 }
 
+// purgeExpired walks the list tail-first, removing any expired entries.
+func (l *lru) purgeExpired() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := time.Now()
+	for ent := l.list.Back(); ent != nil; {
+		prev := ent.Prev()
+		if ent.Value.(*lruItem).expired(now) {
+			l.removeElementReason(ent, ReasonExpired)
+		}
+		ent = prev
+	}
+}
+
+// startJanitor starts a goroutine that periodically purges expired
+// entries. It is only started when a non-zero default TTL is configured.
+func (l *lru) startJanitor(interval time.Duration) {
+	stop := make(chan struct{})
+	l.stopJanitor = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				l.purgeExpired()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stats returns a snapshot of the cache's event counters. It only
+// reports non-zero data when the cache was built via NewLRUWithOptions
+// without a custom Metrics implementation.
+func (l *lru) Stats() Stats {
+	if dm, ok := l.metrics.(*defaultMetrics); ok {
+		return dm.Snapshot()
+	}
+	return Stats{}
+}
+
+// Close stops the background janitor goroutine, if one was started.
+func (l *lru) Close() error {
+	if l.stopJanitor != nil {
+		close(l.stopJanitor)
+		l.stopJanitor = nil
+	}
+	return nil
+}
+
 // removeOldest removes the oldest item from the cache.
 func (l *lru) removeOldest() {
 	ent := l.list.Back()
@@ -143,9 +302,19 @@ func (l *lru) removeOldest() {
 }
 
 func (l *lru) removeElement(e *list.Element) {
+	l.removeElementReason(e, ReasonCapacity)
+}
+
+func (l *lru) removeElementReason(e *list.Element, reason EvictReason) {
 	l.list.Remove(e)
 	entry := e.Value.(*lruItem)
 	delete(l.cache, entry.key)
+	if l.onEvict != nil {
+		l.onEvict(entry.key, entry.value, reason)
+	}
+	if l.metrics != nil {
+		l.metrics.Eviction(reason)
+	}
 }
 
 // NewLRU constructs an lru of the given size
@@ -160,3 +329,45 @@ func NewLRU(capacity int) (*lru, error) {
 	}
 	return c, nil
 }
+
+// NewLRUWithDefaultTTL constructs an lru of the given size whose entries
+// expire after ttl unless added with an explicit TTL or expiration, and
+// starts a janitor goroutine that periodically purges expired entries.
+func NewLRUWithDefaultTTL(capacity int, ttl time.Duration) (*lru, error) {
+	c, err := NewLRU(capacity)
+	if err != nil {
+		return nil, err
+	}
+	c.defaultTTL = ttl
+	if ttl > 0 {
+		c.startJanitor(ttl)
+	}
+	return c, nil
+}
+
+// NewLRUWithOptions constructs an lru of the given size with the
+// provided eviction callback, metrics, and/or default TTL wired in. If
+// no Metrics is supplied via WithMetrics, an in-memory implementation is
+// used and can be read back via Stats.
+func NewLRUWithOptions(capacity int, opts ...Option) (*lru, error) {
+	c, err := NewLRU(capacity)
+	if err != nil {
+		return nil, err
+	}
+	o := &cacheOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	c.onEvict = o.onEvict
+	if o.metrics != nil {
+		c.metrics = o.metrics
+	} else {
+		c.metrics = &defaultMetrics{}
+	}
+
+	c.defaultTTL = o.defaultTTL
+	if o.defaultTTL > 0 {
+		c.startJanitor(o.defaultTTL)
+	}
+	return c, nil
+}
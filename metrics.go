@@ -0,0 +1,103 @@
+package easy_lru_cache
+
+import "sync/atomic"
+
+// EvictReason describes why an entry left a cache.
+type EvictReason int
+
+const (
+	// ReasonCapacity means the entry was evicted to make room for a
+	// new one.
+	ReasonCapacity EvictReason = iota
+	// ReasonExplicit means the entry was removed via Remove.
+	ReasonExplicit
+	// ReasonExpired means the entry's TTL had elapsed.
+	ReasonExpired
+	// ReasonPromoted means the entry moved from the recent to the
+	// frequent list.
+	ReasonPromoted
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case ReasonCapacity:
+		return "capacity"
+	case ReasonExplicit:
+		return "explicit"
+	case ReasonExpired:
+		return "expired"
+	case ReasonPromoted:
+		return "promoted"
+	default:
+		return "unknown"
+	}
+}
+
+// Metrics receives counters for cache events. Implement this to export
+// cache behavior to an external monitoring system.
+type Metrics interface {
+	Hit()
+	Miss()
+	Eviction(reason EvictReason)
+	PromotionR2F()
+	GhostHit()
+}
+
+// Stats is a point-in-time snapshot of a defaultMetrics, returned by a
+// cache's Stats method.
+type Stats struct {
+	Hits              uint64
+	Misses            uint64
+	Evictions         uint64
+	EvictionsByReason map[EvictReason]uint64
+	PromotionsR2F     uint64
+	GhostHits         uint64
+}
+
+// defaultMetrics is the in-memory Metrics implementation used when no
+// custom Metrics is supplied via WithMetrics.
+type defaultMetrics struct {
+	hits, misses, evictions, promotions, ghostHits uint64
+
+	capacityEvictions uint64
+	explicitEvictions uint64
+	expiredEvictions  uint64
+	promotedEvictions uint64
+}
+
+func (m *defaultMetrics) Hit()  { atomic.AddUint64(&m.hits, 1) }
+func (m *defaultMetrics) Miss() { atomic.AddUint64(&m.misses, 1) }
+
+func (m *defaultMetrics) Eviction(reason EvictReason) {
+	atomic.AddUint64(&m.evictions, 1)
+	switch reason {
+	case ReasonCapacity:
+		atomic.AddUint64(&m.capacityEvictions, 1)
+	case ReasonExplicit:
+		atomic.AddUint64(&m.explicitEvictions, 1)
+	case ReasonExpired:
+		atomic.AddUint64(&m.expiredEvictions, 1)
+	case ReasonPromoted:
+		atomic.AddUint64(&m.promotedEvictions, 1)
+	}
+}
+
+func (m *defaultMetrics) PromotionR2F() { atomic.AddUint64(&m.promotions, 1) }
+func (m *defaultMetrics) GhostHit()     { atomic.AddUint64(&m.ghostHits, 1) }
+
+// Snapshot returns a point-in-time copy of the counters.
+func (m *defaultMetrics) Snapshot() Stats {
+	return Stats{
+		Hits:      atomic.LoadUint64(&m.hits),
+		Misses:    atomic.LoadUint64(&m.misses),
+		Evictions: atomic.LoadUint64(&m.evictions),
+		EvictionsByReason: map[EvictReason]uint64{
+			ReasonCapacity: atomic.LoadUint64(&m.capacityEvictions),
+			ReasonExplicit: atomic.LoadUint64(&m.explicitEvictions),
+			ReasonExpired:  atomic.LoadUint64(&m.expiredEvictions),
+			ReasonPromoted: atomic.LoadUint64(&m.promotedEvictions),
+		},
+		PromotionsR2F: atomic.LoadUint64(&m.promotions),
+		GhostHits:     atomic.LoadUint64(&m.ghostHits),
+	}
+}
@@ -0,0 +1,61 @@
+package easy_lru_cache
+
+import "time"
+
+// EvictCallback is invoked when an entry leaves a cache through
+// eviction, expiry or Remove, and by a 2Q cache when an entry is
+// promoted from its recent to its frequent list. Purge does not invoke it.
+type EvictCallback func(key, value interface{}, reason EvictReason)
+
+type cacheOptions struct {
+	onEvict     EvictCallback
+	metrics     Metrics
+	recentRatio float64
+	ghostRatio  float64
+	hasher      Hasher
+	defaultTTL  time.Duration
+}
+
+// Option configures a cache built via New2QWithOptions or
+// NewLRUWithOptions.
+type Option func(*cacheOptions)
+
+// WithEvictCallback registers a callback invoked with an EvictReason
+// for the events described on EvictCallback.
+func WithEvictCallback(cb EvictCallback) Option {
+	return func(o *cacheOptions) { o.onEvict = cb }
+}
+
+// WithMetrics registers a Metrics implementation to receive cache
+// event counters. If not supplied, an in-memory implementation is used
+// and can be read back via the cache's Stats method.
+func WithMetrics(m Metrics) Option {
+	return func(o *cacheOptions) { o.metrics = m }
+}
+
+// WithRecentRatio sets the ratio of a 2Q cache dedicated to recently
+// added entries that have only been accessed once.
+func WithRecentRatio(ratio float64) Option {
+	return func(o *cacheOptions) { o.recentRatio = ratio }
+}
+
+// WithGhostRatio sets the ratio of ghost entries a 2Q cache keeps to
+// track entries recently evicted.
+func WithGhostRatio(ratio float64) Option {
+	return func(o *cacheOptions) { o.ghostRatio = ratio }
+}
+
+// WithHasher overrides the hash function a ShardedCache uses to pick a
+// key's shard. Ignored by non-sharded constructors.
+func WithHasher(h Hasher) Option {
+	return func(o *cacheOptions) { o.hasher = h }
+}
+
+// WithDefaultTTL sets a default TTL applied to entries added without an
+// explicit TTL or expiration, and starts a background janitor goroutine
+// that periodically purges expired entries. It lets a caller combine a
+// default TTL with the other options, such as WithEvictCallback or
+// WithMetrics, which the TTL-only constructors cannot.
+func WithDefaultTTL(ttl time.Duration) Option {
+	return func(o *cacheOptions) { o.defaultTTL = ttl }
+}
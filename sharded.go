@@ -0,0 +1,155 @@
+package easy_lru_cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"reflect"
+)
+
+// Hasher computes a 64-bit hash for a cache key, used to pick a shard
+// in a ShardedCache.
+type Hasher func(key interface{}) uint64
+
+// defaultHasher hashes []byte and string keys directly without going
+// through reflection. Other comparable keys fall back to reflect: integer
+// and bool kinds are hashed as their raw bits, and everything else is
+// hashed as its type plus Go-syntax representation, so distinct types
+// with coincidentally equal %v output don't collide.
+func defaultHasher(key interface{}) uint64 {
+	h := fnv.New64a()
+	switch k := key.(type) {
+	case []byte:
+		h.Write(k)
+	case string:
+		h.Write([]byte(k))
+	default:
+		hashReflect(h, reflect.ValueOf(key))
+	}
+	return h.Sum64()
+}
+
+// hashReflect writes a representation of v into h. Integer and bool kinds
+// are written as their raw bits without their type, so equal values of
+// different integer types hash the same; everything else falls back to a
+// Go-syntax representation prefixed with the key's type.
+func hashReflect(h hash.Hash64, v reflect.Value) {
+	var buf [8]byte
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		binary.LittleEndian.PutUint64(buf[:], uint64(v.Int()))
+		h.Write(buf[:])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		binary.LittleEndian.PutUint64(buf[:], v.Uint())
+		h.Write(buf[:])
+	case reflect.Bool:
+		if v.Bool() {
+			h.Write([]byte{1})
+		} else {
+			h.Write([]byte{0})
+		}
+	default:
+		fmt.Fprintf(h, "%s:%#v", v.Type(), v.Interface())
+	}
+}
+
+// ShardedCache fans a 2Q cache out across N independent shards, each
+// with its own lock, so throughput under concurrent load scales
+// roughly with shard count.
+type ShardedCache struct {
+	shards []*twoQueueCache
+	hasher Hasher
+}
+
+// NewSharded2Q creates a ShardedCache of the given shard count, each
+// shard holding up to sizePerShard entries. Options are applied to
+// every shard, plus WithHasher to override the key-to-shard hash.
+func NewSharded2Q(shards, sizePerShard int, opts ...Option) (*ShardedCache, error) {
+	if shards <= 0 {
+		return nil, errors.New("invalid shard count")
+	}
+
+	o := &cacheOptions{
+		recentRatio: Default2QRecentRatio,
+		ghostRatio:  Default2QGhostEntries,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	hasher := o.hasher
+	if hasher == nil {
+		hasher = defaultHasher
+	}
+
+	sc := &ShardedCache{
+		shards: make([]*twoQueueCache, shards),
+		hasher: hasher,
+	}
+	for i := range sc.shards {
+		shard, err := New2QWithOptions(sizePerShard, opts...)
+		if err != nil {
+			return nil, err
+		}
+		sc.shards[i] = shard
+	}
+	return sc, nil
+}
+
+func (s *ShardedCache) shardFor(key interface{}) *twoQueueCache {
+	idx := s.hasher(key) % uint64(len(s.shards))
+	return s.shards[idx]
+}
+
+// Get looks up a key's value from the cache.
+func (s *ShardedCache) Get(key interface{}) (value interface{}, err error) {
+	return s.shardFor(key).Get(key)
+}
+
+// Add adds a value to the cache.
+func (s *ShardedCache) Add(key, value interface{}) (err error) {
+	return s.shardFor(key).Add(key, value)
+}
+
+// Contains is used to check if the cache contains a key
+// without updating recency or frequency.
+func (s *ShardedCache) Contains(key interface{}) bool {
+	return s.shardFor(key).Contains(key)
+}
+
+// Peek is used to inspect the cache value of a key
+// without updating recency or frequency.
+func (s *ShardedCache) Peek(key interface{}) (value interface{}, ok bool) {
+	return s.shardFor(key).Peek(key)
+}
+
+// Remove removes the provided key from the cache.
+func (s *ShardedCache) Remove(key interface{}) {
+	s.shardFor(key).Remove(key)
+}
+
+// Len returns the number of items in the cache, across all shards.
+func (s *ShardedCache) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+	return total
+}
+
+// Keys returns a slice of the keys in the cache, across all shards.
+func (s *ShardedCache) Keys() []interface{} {
+	var keys []interface{}
+	for _, shard := range s.shards {
+		keys = append(keys, shard.Keys()...)
+	}
+	return keys
+}
+
+// Purge is used to completely clear the cache, across all shards.
+func (s *ShardedCache) Purge() {
+	for _, shard := range s.shards {
+		shard.Purge()
+	}
+}
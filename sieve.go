@@ -0,0 +1,183 @@
+package easy_lru_cache
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+// sieveItem is a single entry in a SieveCache.
+type sieveItem struct {
+	key     interface{}
+	value   interface{}
+	visited bool
+}
+
+// SieveCache is a thread-safe cache implementing the SIEVE eviction
+// policy. Unlike LRU, a hit only flips the entry's visited bit and does
+// not move it in the list, which avoids the per-hit list splicing that
+// dominates LRU cost under read-heavy workloads.
+// https://cachemon.github.io/SIEVE-website/
+type SieveCache struct {
+	capacity int
+	list     *list.List
+	cache    map[interface{}]*list.Element
+	hand     *list.Element
+	lock     sync.Mutex
+}
+
+// NewSieve constructs a SieveCache of the given size.
+func NewSieve(size int) (*SieveCache, error) {
+	if size <= 0 {
+		return nil, errors.New("must provide a positive size")
+	}
+	return &SieveCache{
+		capacity: size,
+		list:     list.New(),
+		cache:    make(map[interface{}]*list.Element),
+	}, nil
+}
+
+// Get looks up a key's value from the cache.
+func (s *SieveCache) Get(key interface{}) (value interface{}, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	element, has := s.cache[key]
+	if !has {
+		err = errors.New("not found in cache")
+		return
+	}
+	item := element.Value.(*sieveItem)
+	item.visited = true
+	value = item.value
+	return
+}
+
+// Put adds a value to the cache.
+func (s *SieveCache) Put(key, value interface{}) (err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if element, ok := s.cache[key]; ok {
+		item := element.Value.(*sieveItem)
+		item.value = value
+		return
+	}
+
+	if s.list.Len() >= s.capacity {
+		s.evict()
+	}
+
+	item := &sieveItem{key: key, value: value}
+	s.cache[key] = s.list.PushFront(item)
+	return
+}
+
+// evict removes the first unvisited entry found starting from hand,
+// walking toward the head of the list and clearing visited bits along
+// the way.
+func (s *SieveCache) evict() {
+	element := s.hand
+	if element == nil {
+		element = s.list.Back()
+	}
+
+	for element != nil {
+		item := element.Value.(*sieveItem)
+		if !item.visited {
+			break
+		}
+		item.visited = false
+		element = element.Prev()
+		if element == nil {
+			element = s.list.Back()
+		}
+	}
+
+	if element == nil {
+		return
+	}
+
+	prev := element.Prev()
+	if prev == nil {
+		prev = s.list.Back()
+		if prev == element {
+			prev = nil
+		}
+	}
+	s.hand = prev
+
+	item := element.Value.(*sieveItem)
+	s.list.Remove(element)
+	delete(s.cache, item.key)
+}
+
+// Contains checks if a key is in the cache, without updating its
+// visited bit.
+func (s *SieveCache) Contains(key interface{}) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.cache[key]
+	return ok
+}
+
+// Peek returns the key's value (if any) without updating its visited bit.
+func (s *SieveCache) Peek(key interface{}) (value interface{}, ok bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	element, has := s.cache[key]
+	if !has {
+		return
+	}
+	value = element.Value.(*sieveItem).value
+	ok = true
+	return
+}
+
+// Remove removes the provided key from the cache, returning if the
+// key was contained.
+func (s *SieveCache) Remove(key interface{}) (ok bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	element, has := s.cache[key]
+	if !has {
+		return false
+	}
+	if s.hand == element {
+		s.hand = element.Prev()
+	}
+	s.list.Remove(element)
+	delete(s.cache, key)
+	return true
+}
+
+// Len returns the number of items in the cache.
+func (s *SieveCache) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.list.Len()
+}
+
+// Keys returns a slice of the keys in the cache, from tail to head.
+func (s *SieveCache) Keys() []interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := make([]interface{}, len(s.cache))
+	i := 0
+	for ent := s.list.Back(); ent != nil; ent = ent.Prev() {
+		keys[i] = ent.Value.(*sieveItem).key
+		i++
+	}
+	return keys
+}
+
+// Purge is used to completely clear the cache.
+func (s *SieveCache) Purge() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k := range s.cache {
+		delete(s.cache, k)
+	}
+	s.list.Init()
+	s.hand = nil
+}
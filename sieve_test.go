@@ -0,0 +1,56 @@
+package easy_lru_cache
+
+import "testing"
+
+// TestSieveCacheEvictsUnvisited fills the cache, marks every entry but the
+// last as visited via Get, then inserts one more key. SIEVE should evict
+// the sole unvisited entry rather than the least-recently-inserted one.
+func TestSieveCacheEvictsUnvisited(t *testing.T) {
+	c, err := NewSieve(3)
+	if err != nil {
+		t.Fatalf("NewSieve: %v", err)
+	}
+
+	for _, k := range []string{"a", "b", "c"} {
+		if err := c.Put(k, k); err != nil {
+			t.Fatalf("Put(%q): %v", k, err)
+		}
+	}
+
+	if _, err := c.Get("a"); err != nil {
+		t.Fatalf("Get(a): %v", err)
+	}
+	if _, err := c.Get("b"); err != nil {
+		t.Fatalf("Get(b): %v", err)
+	}
+
+	if err := c.Put("d", "d"); err != nil {
+		t.Fatalf("Put(d): %v", err)
+	}
+
+	if c.Contains("c") {
+		t.Fatalf("Contains(c) = true, want evicted as the sole unvisited entry")
+	}
+	for _, k := range []string{"a", "b", "d"} {
+		if !c.Contains(k) {
+			t.Fatalf("Contains(%q) = false, want true", k)
+		}
+	}
+}
+
+func TestSieveCacheRemove(t *testing.T) {
+	c, err := NewSieve(2)
+	if err != nil {
+		t.Fatalf("NewSieve: %v", err)
+	}
+	if err := c.Put("a", 1); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	if !c.Remove("a") {
+		t.Fatalf("Remove(a) = false, want true")
+	}
+	if c.Remove("a") {
+		t.Fatalf("Remove(a) = true on already-removed key, want false")
+	}
+}
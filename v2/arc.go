@@ -0,0 +1,206 @@
+package v2
+
+import (
+	"errors"
+	"sync"
+)
+
+// ARCCache is a thread-safe, generic, non-boxing counterpart to the
+// top-level ARCCache. http://www.cs.cmu.edu/~christos/courses/721-resources/p85-megiddo.pdf
+type ARCCache[K comparable, V any] struct {
+	size int
+	p    int
+
+	t1 *LRU[K, V]
+	b1 *LRU[K, V]
+	t2 *LRU[K, V]
+	b2 *LRU[K, V]
+
+	lock sync.RWMutex
+}
+
+// NewARC creates a new ARCCache with the given size.
+func NewARC[K comparable, V any](size int) (*ARCCache[K, V], error) {
+	if size <= 0 {
+		return nil, errors.New("invalid size")
+	}
+
+	t1, err := NewLRU[K, V](size)
+	if err != nil {
+		return nil, err
+	}
+	b1, err := NewLRU[K, V](size)
+	if err != nil {
+		return nil, err
+	}
+	t2, err := NewLRU[K, V](size)
+	if err != nil {
+		return nil, err
+	}
+	b2, err := NewLRU[K, V](size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ARCCache[K, V]{size: size, t1: t1, b1: b1, t2: t2, b2: b2}, nil
+}
+
+// Get looks up a key's value from the cache.
+func (a *ARCCache[K, V]) Get(key K) (value V, ok bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if val, found := a.t1.Peek(key); found {
+		a.t1.Remove(key)
+		a.t2.Put(key, val)
+		return val, true
+	}
+	return a.t2.Get(key)
+}
+
+// Put adds a value to the cache.
+func (a *ARCCache[K, V]) Put(key K, value V) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.t1.Contains(key) {
+		a.t1.Remove(key)
+		a.t2.Put(key, value)
+		return
+	}
+	if a.t2.Contains(key) {
+		a.t2.Put(key, value)
+		return
+	}
+
+	if a.b1.Contains(key) {
+		a.p = minInt(a.size, a.p+maxInt(1, a.b2.Len()/maxInt(1, a.b1.Len())))
+		a.replace(false)
+		a.b1.Remove(key)
+		a.t2.Put(key, value)
+		return
+	}
+
+	if a.b2.Contains(key) {
+		a.p = maxInt(0, a.p-maxInt(1, a.b1.Len()/maxInt(1, a.b2.Len())))
+		a.replace(true)
+		a.b2.Remove(key)
+		a.t2.Put(key, value)
+		return
+	}
+
+	if a.t1.Len()+a.b1.Len() == a.size {
+		if a.t1.Len() < a.size {
+			a.b1.RemoveOldest()
+		} else {
+			a.t1.RemoveOldest()
+		}
+		a.replace(false)
+	} else if a.t1.Len()+a.t2.Len()+a.b1.Len()+a.b2.Len() >= a.size {
+		if a.t1.Len()+a.t2.Len()+a.b1.Len()+a.b2.Len() >= 2*a.size {
+			a.b2.RemoveOldest()
+		}
+		a.replace(false)
+	}
+
+	a.t1.Put(key, value)
+}
+
+// replace evicts the LRU entry of t1 or t2 into the corresponding ghost
+// list, depending on how p compares to the current size of t1.
+func (a *ARCCache[K, V]) replace(inB2 bool) {
+	t1Len := a.t1.Len()
+	if t1Len > 0 && (t1Len >= maxInt(1, a.p) || (inB2 && t1Len == a.p)) {
+		keys := a.t1.Keys()
+		oldest := keys[0]
+		val, _ := a.t1.Peek(oldest)
+		a.t1.Remove(oldest)
+		a.b1.Put(oldest, val)
+		return
+	}
+
+	if a.t2.Len() > 0 {
+		keys := a.t2.Keys()
+		oldest := keys[0]
+		val, _ := a.t2.Peek(oldest)
+		a.t2.Remove(oldest)
+		a.b2.Put(oldest, val)
+	}
+}
+
+// Len returns the number of live items in the cache.
+func (a *ARCCache[K, V]) Len() int {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.t1.Len() + a.t2.Len()
+}
+
+// Keys returns a slice of the keys in the cache.
+// The frequently used keys are first in the returned slice.
+func (a *ARCCache[K, V]) Keys() []K {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	k1 := a.t2.Keys()
+	k2 := a.t1.Keys()
+	return append(k1, k2...)
+}
+
+// Remove removes the provided key from the cache.
+func (a *ARCCache[K, V]) Remove(key K) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.t1.Remove(key) {
+		return true
+	}
+	if a.t2.Remove(key) {
+		return true
+	}
+	if a.b1.Remove(key) {
+		return true
+	}
+	return a.b2.Remove(key)
+}
+
+// Purge is used to completely clear the cache.
+func (a *ARCCache[K, V]) Purge() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.t1.Purge()
+	a.t2.Purge()
+	a.b1.Purge()
+	a.b2.Purge()
+	a.p = 0
+}
+
+// Contains is used to check if the cache contains a key
+// without updating recency or frequency.
+func (a *ARCCache[K, V]) Contains(key K) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.t1.Contains(key) || a.t2.Contains(key)
+}
+
+// Peek is used to inspect the cache value of a key
+// without updating recency or frequency.
+func (a *ARCCache[K, V]) Peek(key K) (value V, ok bool) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if value, ok = a.t1.Peek(key); ok {
+		return
+	}
+	return a.t2.Peek(key)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
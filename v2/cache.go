@@ -0,0 +1,18 @@
+// Package v2 is a generic, typed counterpart to the top-level
+// easy_lru_cache package. It replaces map[interface{}]*list.Element with
+// map[K]*list.Element and stores K/V directly in list items, avoiding the
+// boxing and type assertions that the interface{}-based API pays on every
+// Get/Put. The untyped v1 API is left untouched for backward compatibility.
+package v2
+
+// Cache is the common surface implemented by every cache in this package.
+type Cache[K comparable, V any] interface {
+	Get(key K) (value V, ok bool)
+	Put(key K, value V)
+	Contains(key K) bool
+	Peek(key K) (value V, ok bool)
+	Remove(key K) bool
+	Len() int
+	Keys() []K
+	Purge()
+}
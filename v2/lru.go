@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"container/list"
+	"errors"
+)
+
+type lruItem[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRU implements a non-thread safe fixed size LRU cache using generics,
+// so keys and values are stored without boxing into interface{}.
+type LRU[K comparable, V any] struct {
+	capacity int
+	list     *list.List
+	cache    map[K]*list.Element
+}
+
+// NewLRU constructs an LRU of the given size.
+func NewLRU[K comparable, V any](size int) (*LRU[K, V], error) {
+	if size <= 0 {
+		return nil, errors.New("must provide a positive size")
+	}
+	return &LRU[K, V]{
+		capacity: size,
+		list:     list.New(),
+		cache:    make(map[K]*list.Element),
+	}, nil
+}
+
+// Get looks up a key's value from the cache.
+func (l *LRU[K, V]) Get(key K) (value V, ok bool) {
+	element, has := l.cache[key]
+	if !has {
+		return
+	}
+	l.list.MoveToFront(element)
+	value = element.Value.(*lruItem[K, V]).value
+	ok = true
+	return
+}
+
+// Put adds a value to the cache.
+func (l *LRU[K, V]) Put(key K, value V) {
+	if element, ok := l.cache[key]; ok {
+		l.list.MoveToFront(element)
+		element.Value.(*lruItem[K, V]).value = value
+		return
+	}
+
+	if l.list.Len() >= l.capacity {
+		l.removeOldest()
+	}
+	item := &lruItem[K, V]{key: key, value: value}
+	l.cache[key] = l.list.PushFront(item)
+}
+
+// Peek returns the key's value (if any) without updating the
+// "recently used"-ness of the key.
+func (l *LRU[K, V]) Peek(key K) (value V, ok bool) {
+	if element, has := l.cache[key]; has {
+		value = element.Value.(*lruItem[K, V]).value
+		ok = true
+	}
+	return
+}
+
+// Contains checks if a key is in the cache, without updating the
+// recent-ness or deleting it for being stale.
+func (l *LRU[K, V]) Contains(key K) bool {
+	_, ok := l.cache[key]
+	return ok
+}
+
+// Remove removes the provided key from the cache, returning if the
+// key was contained.
+func (l *LRU[K, V]) Remove(key K) bool {
+	element, has := l.cache[key]
+	if has {
+		l.removeElement(element)
+	}
+	return has
+}
+
+// Len returns the number of items in the cache.
+func (l *LRU[K, V]) Len() int {
+	return l.list.Len()
+}
+
+// RemoveOldest removes the oldest item from the cache.
+func (l *LRU[K, V]) RemoveOldest() {
+	l.removeOldest()
+}
+
+// Keys returns a slice of the keys in the cache, from oldest to newest.
+func (l *LRU[K, V]) Keys() []K {
+	keys := make([]K, len(l.cache))
+	i := 0
+	for ent := l.list.Back(); ent != nil; ent = ent.Prev() {
+		keys[i] = ent.Value.(*lruItem[K, V]).key
+		i++
+	}
+	return keys
+}
+
+// Purge is used to completely clear the cache.
+func (l *LRU[K, V]) Purge() {
+	for k := range l.cache {
+		delete(l.cache, k)
+	}
+	l.list.Init()
+}
+
+func (l *LRU[K, V]) removeOldest() {
+	ent := l.list.Back()
+	if ent != nil {
+		l.removeElement(ent)
+	}
+}
+
+func (l *LRU[K, V]) removeElement(e *list.Element) {
+	l.list.Remove(e)
+	entry := e.Value.(*lruItem[K, V])
+	delete(l.cache, entry.key)
+}
@@ -0,0 +1,42 @@
+package v2
+
+const (
+	// DefaultRecentRatio is the ratio of the 2Q cache dedicated
+	// to recently added entries that have only been accessed once.
+	DefaultRecentRatio = 0.20
+
+	// DefaultGhostRatio is the default ratio of ghost entries kept
+	// to track entries recently evicted.
+	DefaultGhostRatio = 0.80
+)
+
+type options struct {
+	recentRatio float64
+	ghostRatio  float64
+}
+
+// Option configures a generic cache constructor.
+type Option func(*options)
+
+// WithRecentRatio sets the ratio of the cache dedicated to recently
+// added entries that have only been accessed once.
+func WithRecentRatio(ratio float64) Option {
+	return func(o *options) { o.recentRatio = ratio }
+}
+
+// WithGhostRatio sets the ratio of ghost entries kept to track entries
+// recently evicted.
+func WithGhostRatio(ratio float64) Option {
+	return func(o *options) { o.ghostRatio = ratio }
+}
+
+func newOptions(opts ...Option) options {
+	o := options{
+		recentRatio: DefaultRecentRatio,
+		ghostRatio:  DefaultGhostRatio,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
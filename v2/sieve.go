@@ -0,0 +1,172 @@
+package v2
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+type sieveItem[K comparable, V any] struct {
+	key     K
+	value   V
+	visited bool
+}
+
+// SieveCache is a thread-safe, generic, non-boxing counterpart to the
+// top-level SieveCache. https://cachemon.github.io/SIEVE-website/
+type SieveCache[K comparable, V any] struct {
+	capacity int
+	list     *list.List
+	cache    map[K]*list.Element
+	hand     *list.Element
+	lock     sync.Mutex
+}
+
+// NewSieve constructs a SieveCache of the given size.
+func NewSieve[K comparable, V any](size int) (*SieveCache[K, V], error) {
+	if size <= 0 {
+		return nil, errors.New("must provide a positive size")
+	}
+	return &SieveCache[K, V]{
+		capacity: size,
+		list:     list.New(),
+		cache:    make(map[K]*list.Element),
+	}, nil
+}
+
+// Get looks up a key's value from the cache.
+func (s *SieveCache[K, V]) Get(key K) (value V, ok bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	element, has := s.cache[key]
+	if !has {
+		return
+	}
+	item := element.Value.(*sieveItem[K, V])
+	item.visited = true
+	return item.value, true
+}
+
+// Put adds a value to the cache.
+func (s *SieveCache[K, V]) Put(key K, value V) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if element, ok := s.cache[key]; ok {
+		element.Value.(*sieveItem[K, V]).value = value
+		return
+	}
+
+	if s.list.Len() >= s.capacity {
+		s.evict()
+	}
+
+	item := &sieveItem[K, V]{key: key, value: value}
+	s.cache[key] = s.list.PushFront(item)
+}
+
+// evict removes the first unvisited entry found starting from hand,
+// walking toward the head of the list and clearing visited bits along
+// the way.
+func (s *SieveCache[K, V]) evict() {
+	element := s.hand
+	if element == nil {
+		element = s.list.Back()
+	}
+
+	for element != nil {
+		item := element.Value.(*sieveItem[K, V])
+		if !item.visited {
+			break
+		}
+		item.visited = false
+		element = element.Prev()
+		if element == nil {
+			element = s.list.Back()
+		}
+	}
+
+	if element == nil {
+		return
+	}
+
+	prev := element.Prev()
+	if prev == nil {
+		prev = s.list.Back()
+		if prev == element {
+			prev = nil
+		}
+	}
+	s.hand = prev
+
+	item := element.Value.(*sieveItem[K, V])
+	s.list.Remove(element)
+	delete(s.cache, item.key)
+}
+
+// Contains checks if a key is in the cache, without updating its
+// visited bit.
+func (s *SieveCache[K, V]) Contains(key K) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.cache[key]
+	return ok
+}
+
+// Peek returns the key's value (if any) without updating its visited bit.
+func (s *SieveCache[K, V]) Peek(key K) (value V, ok bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	element, has := s.cache[key]
+	if !has {
+		return
+	}
+	return element.Value.(*sieveItem[K, V]).value, true
+}
+
+// Remove removes the provided key from the cache, returning if the
+// key was contained.
+func (s *SieveCache[K, V]) Remove(key K) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	element, has := s.cache[key]
+	if !has {
+		return false
+	}
+	if s.hand == element {
+		s.hand = element.Prev()
+	}
+	s.list.Remove(element)
+	delete(s.cache, key)
+	return true
+}
+
+// Len returns the number of items in the cache.
+func (s *SieveCache[K, V]) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.list.Len()
+}
+
+// Keys returns a slice of the keys in the cache, from tail to head.
+func (s *SieveCache[K, V]) Keys() []K {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := make([]K, len(s.cache))
+	i := 0
+	for ent := s.list.Back(); ent != nil; ent = ent.Prev() {
+		keys[i] = ent.Value.(*sieveItem[K, V]).key
+		i++
+	}
+	return keys
+}
+
+// Purge is used to completely clear the cache.
+func (s *SieveCache[K, V]) Purge() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k := range s.cache {
+		delete(s.cache, k)
+	}
+	s.list.Init()
+	s.hand = nil
+}
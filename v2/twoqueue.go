@@ -0,0 +1,173 @@
+package v2
+
+import (
+	"errors"
+	"sync"
+)
+
+// TwoQueueCache is a thread-safe, generic, non-boxing counterpart to the
+// top-level twoQueueCache. https://medium.com/@koushikmohan/an-analysis-of-2q-cache-replacement-algorithms-21acceae672a
+type TwoQueueCache[K comparable, V any] struct {
+	size       int
+	recentSize int
+
+	recent      *LRU[K, V]
+	frequent    *LRU[K, V]
+	recentEvict *LRU[K, V]
+	lock        sync.RWMutex
+}
+
+// New2Q creates a new TwoQueueCache using the given size and options.
+func New2Q[K comparable, V any](size int, opts ...Option) (*TwoQueueCache[K, V], error) {
+	if size <= 0 {
+		return nil, errors.New("invalid size")
+	}
+	o := newOptions(opts...)
+	if o.recentRatio < 0.0 || o.recentRatio > 1.0 {
+		return nil, errors.New("invalid recent ratio")
+	}
+	if o.ghostRatio < 0.0 || o.ghostRatio > 1.0 {
+		return nil, errors.New("invalid ghost ratio")
+	}
+
+	recentSize := int(float64(size) * o.recentRatio)
+	evictSize := int(float64(size) * o.ghostRatio)
+
+	recent, err := NewLRU[K, V](size)
+	if err != nil {
+		return nil, err
+	}
+	frequent, err := NewLRU[K, V](size)
+	if err != nil {
+		return nil, err
+	}
+	recentEvict, err := NewLRU[K, V](evictSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TwoQueueCache[K, V]{
+		size:        size,
+		recentSize:  recentSize,
+		recent:      recent,
+		frequent:    frequent,
+		recentEvict: recentEvict,
+	}, nil
+}
+
+// Get looks up a key's value from the cache.
+func (t *TwoQueueCache[K, V]) Get(key K) (value V, ok bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if value, ok = t.frequent.Get(key); ok {
+		return
+	}
+
+	if value, ok = t.recent.Peek(key); ok {
+		t.recent.Remove(key)
+		t.frequent.Put(key, value)
+	}
+	return
+}
+
+// Put adds a value to the cache.
+func (t *TwoQueueCache[K, V]) Put(key K, value V) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.frequent.Contains(key) {
+		t.frequent.Put(key, value)
+		return
+	}
+
+	if t.recent.Contains(key) {
+		t.recent.Remove(key)
+		t.frequent.Put(key, value)
+		return
+	}
+
+	if t.recentEvict.Contains(key) {
+		t.ensureSpace(true)
+		t.recentEvict.Remove(key)
+		t.frequent.Put(key, value)
+		return
+	}
+
+	t.ensureSpace(false)
+	t.recent.Put(key, value)
+}
+
+// ensureSpace is used to ensure we have space in the cache.
+func (t *TwoQueueCache[K, V]) ensureSpace(recentEvict bool) {
+	recentLen := t.recent.Len()
+	freqLen := t.frequent.Len()
+	if recentLen+freqLen < t.size {
+		return
+	}
+
+	if recentLen > 0 && (recentLen > t.recentSize || (recentLen == t.recentSize && !recentEvict)) {
+		t.recent.RemoveOldest()
+		return
+	}
+
+	t.frequent.RemoveOldest()
+}
+
+// Len returns the number of items in the cache.
+func (t *TwoQueueCache[K, V]) Len() int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.recent.Len() + t.frequent.Len()
+}
+
+// Keys returns a slice of the keys in the cache.
+// The frequently used keys are first in the returned slice.
+func (t *TwoQueueCache[K, V]) Keys() []K {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	k1 := t.frequent.Keys()
+	k2 := t.recent.Keys()
+	return append(k1, k2...)
+}
+
+// Remove removes the provided key from the cache.
+func (t *TwoQueueCache[K, V]) Remove(key K) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.frequent.Remove(key) {
+		return true
+	}
+	if t.recent.Remove(key) {
+		return true
+	}
+	return t.recentEvict.Remove(key)
+}
+
+// Purge is used to completely clear the cache.
+func (t *TwoQueueCache[K, V]) Purge() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.recent.Purge()
+	t.frequent.Purge()
+	t.recentEvict.Purge()
+}
+
+// Contains is used to check if the cache contains a key
+// without updating recency or frequency.
+func (t *TwoQueueCache[K, V]) Contains(key K) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.frequent.Contains(key) || t.recent.Contains(key)
+}
+
+// Peek is used to inspect the cache value of a key
+// without updating recency or frequency.
+func (t *TwoQueueCache[K, V]) Peek(key K) (value V, ok bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	if value, ok = t.frequent.Peek(key); ok {
+		return
+	}
+	return t.recent.Peek(key)
+}